@@ -1217,6 +1217,10 @@ func cliHumanFormatError(from string, err error, opts *formatOpts) (string, bool
 		return formatCoderSDKError(from, sdkError, opts), true
 	}
 
+	if rateLimitErr, ok := err.(*codersdk.RateLimitError); ok {
+		return formatCoderSDKError(from, rateLimitErr.Err, opts), true
+	}
+
 	if cmdErr, ok := err.(*serpent.RunCommandError); ok {
 		// no need to pass the "from" context to this since it is always
 		// top level. We care about what is below this.
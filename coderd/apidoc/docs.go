@@ -8053,6 +8053,40 @@ const docTemplate = `{
                 }
             }
         },
+        "/users/{user}/external-auth": {
+            "get": {
+                "security": [
+                    {
+                        "CoderSessionToken": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Git"
+                ],
+                "summary": "Get external auths by user",
+                "operationId": "get-external-auths-by-user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID, name, or me",
+                        "name": "user",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/codersdk.ListUserExternalAuthResponse"
+                        }
+                    }
+                }
+            }
+        },
         "/users/{user}/gitsshkey": {
             "get": {
                 "security": [
@@ -15219,6 +15253,41 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.ExternalAuthLinkProvider": {
+            "type": "object",
+            "properties": {
+                "allow_refresh": {
+                    "type": "boolean"
+                },
+                "allow_validate": {
+                    "type": "boolean"
+                },
+                "code_challenge_methods_supported": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "device": {
+                    "type": "boolean"
+                },
+                "display_icon": {
+                    "type": "string"
+                },
+                "display_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "supports_revocation": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
         "codersdk.ExternalAuthUser": {
             "type": "object",
             "properties": {
@@ -15686,6 +15755,24 @@ const docTemplate = `{
                 }
             }
         },
+        "codersdk.ListUserExternalAuthResponse": {
+            "type": "object",
+            "properties": {
+                "links": {
+                    "description": "Links are all the authenticated links for the user.\nIf a link has a provider ID that does not exist, then that provider\nis no longer configured, rendering it unusable. It is still valuable\nto include these links so that the user can unlink them.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.ExternalAuthLink"
+                    }
+                },
+                "providers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/codersdk.ExternalAuthLinkProvider"
+                    }
+                }
+            }
+        },
         "codersdk.LogLevel": {
             "type": "string",
             "enum": [
@@ -1,12 +1,14 @@
 package coderd
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 
+	"github.com/google/uuid"
 	"github.com/sqlc-dev/pqtype"
 	"golang.org/x/sync/errgroup"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/coder/coder/v2/coderd/externalauth"
 	"github.com/coder/coder/v2/coderd/httpapi"
 	"github.com/coder/coder/v2/coderd/httpmw"
+	"github.com/coder/coder/v2/coderd/rbac/policy"
 	"github.com/coder/coder/v2/coderd/util/slice"
 	"github.com/coder/coder/v2/codersdk"
 )
@@ -80,7 +83,9 @@ func (api *API) externalAuthByID(w http.ResponseWriter, r *http.Request) {
 	httpapi.Write(ctx, w, http.StatusOK, res)
 }
 
-// deleteExternalAuthByID only deletes the link on the Coder side, does not revoke the token on the provider side.
+// deleteExternalAuthByID deletes the link on the Coder side and, if the provider
+// has a revoke URL configured, attempts to revoke the token on the provider side.
+// A failed revocation does not fail the request; it is reported in the response.
 //
 // @Summary Delete external auth user link by ID
 // @ID delete-external-auth-user-link-by-id
@@ -350,17 +355,58 @@ func (api *API) listUserExternalAuths(rw http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	key := httpmw.APIKey(r)
 
-	links, err := api.Database.GetExternalAuthLinksByUserID(ctx, key.UserID)
+	resp, ok := api.userExternalAuths(ctx, rw, key.UserID)
+	if !ok {
+		return
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
+// userExternalAuthLinks lists all external auths available to the given user
+// and their auth links if they exist. This lets admins debug a user's external
+// auth without impersonating them.
+//
+// @Summary Get external auths by user
+// @ID get-external-auths-by-user
+// @Security CoderSessionToken
+// @Produce json
+// @Tags Git
+// @Param user path string true "User ID, name, or me"
+// @Success 200 {object} codersdk.ListUserExternalAuthResponse
+// @Router /users/{user}/external-auth [get]
+func (api *API) userExternalAuthLinks(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	user := httpmw.UserParam(r)
+
+	// Links are only filtered by dbauthz, so check explicitly to return a
+	// 403 instead of an empty list.
+	if !api.Authorize(r, policy.ActionReadPersonal, user) {
+		httpapi.Forbidden(rw)
+		return
+	}
+
+	resp, ok := api.userExternalAuths(ctx, rw, user.ID)
+	if !ok {
+		return
+	}
+	httpapi.Write(ctx, rw, http.StatusOK, resp)
+}
+
+// userExternalAuths returns the configured providers and the links of the
+// given user, refreshing each link to report whether it is still
+// authenticated. If it returns false, an error response has been written.
+func (api *API) userExternalAuths(ctx context.Context, rw http.ResponseWriter, userID uuid.UUID) (codersdk.ListUserExternalAuthResponse, bool) {
+	links, err := api.Database.GetExternalAuthLinksByUserID(ctx, userID)
 	if err != nil {
 		if httpapi.Is404Error(err) {
 			httpapi.ResourceNotFound(rw)
-			return
+			return codersdk.ListUserExternalAuthResponse{}, false
 		}
 		httpapi.Write(ctx, rw, http.StatusInternalServerError, codersdk.Response{
 			Message: "Internal error fetching user's external auths.",
 			Detail:  err.Error(),
 		})
-		return
+		return codersdk.ListUserExternalAuthResponse{}, false
 	}
 
 	// This process of authenticating each external link increases the
@@ -399,10 +445,10 @@ func (api *API) listUserExternalAuths(rw http.ResponseWriter, r *http.Request) {
 	// refresh expired tokens too. For now, I do not want to cause the excess
 	// traffic on this request, so the user will have to do this with a separate
 	// call.
-	httpapi.Write(ctx, rw, http.StatusOK, codersdk.ListUserExternalAuthResponse{
+	return codersdk.ListUserExternalAuthResponse{
 		Providers: ExternalAuthConfigs(api.ExternalAuthConfigs),
 		Links:     db2sdk.ExternalAuths(links, linkMeta),
-	})
+	}, true
 }
 
 func ExternalAuthConfigs(auths []*externalauth.Config) []codersdk.ExternalAuthLinkProvider {
@@ -331,6 +331,50 @@ func TestExternalAuthManagement(t *testing.T) {
 		require.True(t, githubCalled, "github should be refreshed")
 		require.True(t, gitlabCalled, "gitlab should be refreshed")
 	})
+	t.Run("ListByUser", func(t *testing.T) {
+		t.Parallel()
+		const githubID = "fake-github"
+		const gitlabID = "fake-gitlab"
+
+		github := oidctest.NewFakeIDP(t, oidctest.WithServing())
+		gitlab := oidctest.NewFakeIDP(t, oidctest.WithServing())
+
+		owner := coderdtest.New(t, &coderdtest.Options{
+			ExternalAuthConfigs: []*externalauth.Config{
+				github.ExternalAuthConfig(t, githubID, nil, func(cfg *externalauth.Config) {
+					cfg.Type = codersdk.EnhancedExternalAuthProviderGitHub.String()
+				}),
+				gitlab.ExternalAuthConfig(t, gitlabID, nil, func(cfg *externalauth.Config) {
+					cfg.Type = codersdk.EnhancedExternalAuthProviderGitLab.String()
+				}),
+			},
+		})
+		ownerUser := coderdtest.CreateFirstUser(t, owner)
+		client, user := coderdtest.CreateAnotherUser(t, owner, ownerUser.OrganizationID)
+		other, _ := coderdtest.CreateAnotherUser(t, owner, ownerUser.OrganizationID)
+		ctx := testutil.Context(t, testutil.WaitLong)
+
+		github.ExternalLogin(t, client)
+
+		// An admin can see the user's links.
+		list, err := owner.GetUserExternalAuthLinks(ctx, user.ID.String())
+		require.NoError(t, err)
+		require.Len(t, list.Providers, 2)
+		require.Len(t, list.Links, 1)
+		require.Equal(t, githubID, list.Links[0].ProviderID)
+		require.True(t, list.Links[0].Authenticated)
+
+		// Users can see their own links.
+		list, err = client.GetUserExternalAuthLinks(ctx, codersdk.Me)
+		require.NoError(t, err)
+		require.Len(t, list.Links, 1)
+
+		// Other users cannot.
+		_, err = other.GetUserExternalAuthLinks(ctx, user.ID.String())
+		var sdkErr *codersdk.Error
+		require.ErrorAs(t, err, &sdkErr)
+		require.Equal(t, http.StatusForbidden, sdkErr.StatusCode())
+	})
 }
 
 func TestExternalAuthDevice(t *testing.T) {
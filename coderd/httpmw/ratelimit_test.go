@@ -49,6 +49,36 @@ func TestRateLimit(t *testing.T) {
 		}
 	})
 
+	t.Run("RetryAfter", func(t *testing.T) {
+		t.Parallel()
+		rtr := chi.NewRouter()
+		rtr.Use(httpmw.RateLimit(1, time.Minute))
+		rtr.Get("/", func(rw http.ResponseWriter, r *http.Request) {
+			rw.WriteHeader(http.StatusOK)
+		})
+
+		req := httptest.NewRequest("GET", "/", nil)
+		rec := httptest.NewRecorder()
+		rtr.ServeHTTP(rec, req)
+		resp := rec.Result()
+		_ = resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Empty(t, resp.Header.Get("Retry-After"))
+
+		req = httptest.NewRequest("GET", "/", nil)
+		rec = httptest.NewRecorder()
+		rtr.ServeHTTP(rec, req)
+		resp = rec.Result()
+		require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+		require.Equal(t, "60", resp.Header.Get("Retry-After"))
+
+		// The SDK should surface the header so callers can back off.
+		err := codersdk.ReadBodyAsError(resp)
+		var rateLimitErr *codersdk.RateLimitError
+		require.ErrorAs(t, err, &rateLimitErr)
+		require.Equal(t, time.Minute, rateLimitErr.RetryAfter)
+	})
+
 	t.Run("RandomIPs", func(t *testing.T) {
 		t.Parallel()
 		rtr := chi.NewRouter()
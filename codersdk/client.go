@@ -12,8 +12,10 @@ import (
 	"net/http"
 	"net/http/httputil"
 	"net/url"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
@@ -413,10 +415,18 @@ func ReadBodyAsError(res *http.Response) error {
 	}
 
 	var helpMessage string
-	if res.StatusCode == http.StatusUnauthorized {
+	var retryAfter time.Duration
+	switch res.StatusCode {
+	case http.StatusUnauthorized:
 		// 401 means the user is not logged in
 		// 403 would mean that the user is not authorized
 		helpMessage = "Try logging in using 'coder login'."
+	case http.StatusTooManyRequests:
+		retryAfter = parseRetryAfter(res.Header.Get("Retry-After"))
+		helpMessage = "Try again later."
+		if retryAfter > 0 {
+			helpMessage = fmt.Sprintf("Try again in %s.", retryAfter)
+		}
 	}
 
 	resp, err := io.ReadAll(res.Body)
@@ -431,7 +441,7 @@ func ReadBodyAsError(res *http.Response) error {
 		if len(resp) == 0 {
 			resp = []byte("no response body")
 		}
-		return &Error{
+		return wrapRateLimitError(&Error{
 			statusCode: res.StatusCode,
 			method:     requestMethod,
 			url:        requestURL,
@@ -440,20 +450,20 @@ func ReadBodyAsError(res *http.Response) error {
 				Detail:  string(resp),
 			},
 			Helper: helpMessage,
-		}
+		}, retryAfter)
 	}
 
 	var m Response
 	err = json.NewDecoder(bytes.NewBuffer(resp)).Decode(&m)
 	if err != nil {
 		if errors.Is(err, io.EOF) {
-			return &Error{
+			return wrapRateLimitError(&Error{
 				statusCode: res.StatusCode,
 				Response: Response{
 					Message: "empty response body",
 				},
 				Helper: helpMessage,
-			}
+			}, retryAfter)
 		}
 		return xerrors.Errorf("decode body: %w", err)
 	}
@@ -465,13 +475,71 @@ func ReadBodyAsError(res *http.Response) error {
 		m.Detail = string(resp)
 	}
 
-	return &Error{
+	return wrapRateLimitError(&Error{
 		Response:   m,
 		statusCode: res.StatusCode,
 		method:     requestMethod,
 		url:        requestURL,
 		Helper:     helpMessage,
+	}, retryAfter)
+}
+
+// wrapRateLimitError returns err as a *RateLimitError if it represents a 429
+// response, otherwise it returns err unchanged.
+func wrapRateLimitError(err *Error, retryAfter time.Duration) error {
+	if err.statusCode != http.StatusTooManyRequests {
+		return err
+	}
+	return &RateLimitError{
+		Err:        err,
+		RetryAfter: retryAfter,
+	}
+}
+
+// parseRetryAfter parses a Retry-After header value, which is either a number
+// of seconds or an HTTP date. It returns 0 if the value is empty, malformed or
+// already in the past.
+func parseRetryAfter(value string) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if d := time.Until(date).Round(time.Second); d > 0 {
+			return d
+		}
 	}
+	return 0
+}
+
+// RateLimitError is returned when the API responds with 429 Too Many Requests.
+// It wraps the underlying *Error, so errors.As checks for *Error continue to
+// work.
+// @typescript-ignore RateLimitError
+type RateLimitError struct {
+	Err *Error
+	// RetryAfter is how long the server asked the client to wait before
+	// retrying, parsed from the Retry-After header. It is zero if the server
+	// did not provide a usable value.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return e.Err
+}
+
+func (e *RateLimitError) StatusCode() int {
+	return e.Err.StatusCode()
 }
 
 // Error represents an unaccepted or invalid request to the API.
@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/stretchr/testify/assert"
@@ -320,6 +321,75 @@ func Test_readBodyAsError(t *testing.T) {
 				assert.Equal(t, unexpectedJSON, sdkErr.Response.Detail)
 			},
 		},
+		{
+			name: "RateLimitedRetryAfterSeconds",
+			req:  nil,
+			res: func() *http.Response {
+				res := newResponse(http.StatusTooManyRequests, jsonCT, marshal(simpleResponse))
+				res.Header.Set("Retry-After", "30")
+				return res
+			}(),
+			assert: func(t *testing.T, err error) {
+				var rateLimitErr *RateLimitError
+				require.True(t, xerrors.As(err, &rateLimitErr))
+				assert.Equal(t, 30*time.Second, rateLimitErr.RetryAfter)
+				assert.Equal(t, http.StatusTooManyRequests, rateLimitErr.StatusCode())
+
+				// The underlying *Error is still reachable for existing callers.
+				sdkErr := assertSDKError(t, err)
+				assert.Equal(t, simpleResponse, sdkErr.Response)
+				assert.Contains(t, sdkErr.Helper, "30s")
+			},
+		},
+		{
+			name: "RateLimitedRetryAfterDate",
+			req:  nil,
+			res: func() *http.Response {
+				res := newResponse(http.StatusTooManyRequests, jsonCT, marshal(simpleResponse))
+				res.Header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+				return res
+			}(),
+			assert: func(t *testing.T, err error) {
+				var rateLimitErr *RateLimitError
+				require.True(t, xerrors.As(err, &rateLimitErr))
+				assert.Greater(t, rateLimitErr.RetryAfter, 58*time.Minute)
+				assert.LessOrEqual(t, rateLimitErr.RetryAfter, time.Hour)
+			},
+		},
+		{
+			name: "RateLimitedNoRetryAfter",
+			req:  nil,
+			res:  newResponse(http.StatusTooManyRequests, jsonCT, marshal(simpleResponse)),
+			assert: func(t *testing.T, err error) {
+				var rateLimitErr *RateLimitError
+				require.True(t, xerrors.As(err, &rateLimitErr))
+				assert.Zero(t, rateLimitErr.RetryAfter)
+			},
+		},
+		{
+			name: "RateLimitedNonJSON",
+			req:  nil,
+			res: func() *http.Response {
+				res := newResponse(http.StatusTooManyRequests, "text/plain", "slow down")
+				res.Header.Set("Retry-After", "5")
+				return res
+			}(),
+			assert: func(t *testing.T, err error) {
+				var rateLimitErr *RateLimitError
+				require.True(t, xerrors.As(err, &rateLimitErr))
+				assert.Equal(t, 5*time.Second, rateLimitErr.RetryAfter)
+				assert.Equal(t, "slow down", rateLimitErr.Err.Detail)
+			},
+		},
+		{
+			name: "NotRateLimited",
+			req:  nil,
+			res:  newResponse(http.StatusNotFound, jsonCT, marshal(simpleResponse)),
+			assert: func(t *testing.T, err error) {
+				var rateLimitErr *RateLimitError
+				require.False(t, xerrors.As(err, &rateLimitErr))
+			},
+		},
 		{
 			// Even status code 200 should be considered an error if this function
 			// is called. There are parts of the code that require this function
@@ -345,6 +415,34 @@ func Test_readBodyAsError(t *testing.T) {
 	}
 }
 
+func Test_Client_RateLimited(t *testing.T) {
+	t.Parallel()
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", jsonCT)
+		w.Header().Set("Retry-After", "12")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = io.WriteString(w, marshal(Response{Message: "You've been rate limited."}))
+	}))
+	defer s.Close()
+
+	u, err := url.Parse(s.URL)
+	require.NoError(t, err)
+	client := New(u)
+
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.WaitLong)
+	defer cancel()
+
+	_, err = client.BuildInfo(ctx)
+	require.Error(t, err)
+
+	var rateLimitErr *RateLimitError
+	require.True(t, xerrors.As(err, &rateLimitErr))
+	require.Equal(t, 12*time.Second, rateLimitErr.RetryAfter)
+	require.Equal(t, http.StatusTooManyRequests, rateLimitErr.StatusCode())
+	require.Contains(t, err.Error(), "You've been rate limited.")
+}
+
 func assertSDKError(t *testing.T, err error) *Error {
 	t.Helper()
 
@@ -174,7 +174,8 @@ func (c *Client) ExternalAuthByID(ctx context.Context, provider string) (Externa
 }
 
 // UnlinkExternalAuthByID deletes the external auth for the given provider by ID
-// for the user. This does not revoke the token from the IDP.
+// for the user. If the provider supports revocation, the server also attempts to
+// revoke the token from the IDP and reports the outcome in the response.
 func (c *Client) UnlinkExternalAuthByID(ctx context.Context, provider string) (DeleteExternalAuthByIDResponse, error) {
 	noRevoke := DeleteExternalAuthByIDResponse{TokenRevoked: false}
 	res, err := c.Request(ctx, http.MethodDelete, fmt.Sprintf("/api/v2/external-auth/%s", provider), nil)
@@ -207,3 +208,19 @@ func (c *Client) ListExternalAuths(ctx context.Context) (ListUserExternalAuthRes
 	var extAuth ListUserExternalAuthResponse
 	return extAuth, json.NewDecoder(res.Body).Decode(&extAuth)
 }
+
+// GetUserExternalAuthLinks returns the available external auth providers and the
+// authenticated links of the given user. Reading another user's links requires
+// admin permissions.
+func (c *Client) GetUserExternalAuthLinks(ctx context.Context, user string) (ListUserExternalAuthResponse, error) {
+	res, err := c.Request(ctx, http.MethodGet, fmt.Sprintf("/api/v2/users/%s/external-auth", user), nil)
+	if err != nil {
+		return ListUserExternalAuthResponse{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return ListUserExternalAuthResponse{}, ReadBodyAsError(res)
+	}
+	var extAuth ListUserExternalAuthResponse
+	return extAuth, json.NewDecoder(res.Body).Decode(&extAuth)
+}